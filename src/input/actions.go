@@ -0,0 +1,274 @@
+package input
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/go-gl/glfw/v3.2/glfw"
+)
+
+// binding is a single key + modifier requirement bound to an action.
+type binding struct {
+	Key  glfw.Key
+	Mods glfw.ModifierKey
+}
+
+// ActionMap maps semantic action names, like "jump" or "move_left", to one
+// or more key bindings, and keeps an InputState so it can answer whether an
+// action is currently active. Register it as a KeyboardListener alongside
+// its InputState to receive OnActionPressed callbacks.
+type ActionMap struct {
+	state    *InputState
+	bindings map[string][]binding
+	handlers map[string][]func()
+}
+
+// NewActionMap creates an ActionMap backed by state for polling.
+func NewActionMap(state *InputState) *ActionMap {
+	return &ActionMap{
+		state:    state,
+		bindings: make(map[string][]binding),
+		handlers: make(map[string][]func()),
+	}
+}
+
+// BindAction binds key (with the given modifier requirement) to name,
+// in addition to any bindings name already has.
+func (m *ActionMap) BindAction(name string, key glfw.Key, mods glfw.ModifierKey) {
+	m.bindings[name] = append(m.bindings[name], binding{Key: key, Mods: mods})
+}
+
+// UnbindAction removes all bindings for name.
+func (m *ActionMap) UnbindAction(name string) {
+	delete(m.bindings, name)
+}
+
+// IsActionActive reports whether any binding for name is currently pressed
+// with at least its required modifiers held. Requiring only that b.Mods be
+// a subset of what's held, rather than an exact match, lets an action bind
+// to a modifier key itself (e.g. Mods: 0, Key: LeftShift) and keeps a
+// plain binding active while an unrelated modifier is also held.
+func (m *ActionMap) IsActionActive(name string) bool {
+	for _, b := range m.bindings[name] {
+		if m.state.IsKeyPressed(b.Key) && m.state.ActiveMods()&b.Mods == b.Mods {
+			return true
+		}
+	}
+	return false
+}
+
+// OnActionPressed registers fn to be called whenever a binding for name
+// transitions from released to pressed.
+func (m *ActionMap) OnActionPressed(name string, fn func()) {
+	m.handlers[name] = append(m.handlers[name], fn)
+}
+
+// KeyDown forwards to state, then fires any handlers for actions bound to
+// key. It satisfies KeyboardListener.
+func (m *ActionMap) KeyDown(key glfw.Key, scancode i32, mods glfw.ModifierKey) {
+	m.state.KeyDown(key, scancode, mods)
+	for name, bindings := range m.bindings {
+		for _, b := range bindings {
+			if b.Key == key && mods&b.Mods == b.Mods {
+				for _, fn := range m.handlers[name] {
+					fn()
+				}
+			}
+		}
+	}
+}
+
+// KeyUp forwards to state. It satisfies KeyboardListener.
+func (m *ActionMap) KeyUp(key glfw.Key, scancode i32, mods glfw.ModifierKey) {
+	m.state.KeyUp(key, scancode, mods)
+}
+
+// KeyRepeat forwards to state. It satisfies KeyboardListener.
+func (m *ActionMap) KeyRepeat(key glfw.Key, scancode i32, mods glfw.ModifierKey) {
+	m.state.KeyRepeat(key, scancode, mods)
+}
+
+// keymapFile is the on-disk JSON representation of an ActionMap's bindings,
+// using human-readable key and modifier names instead of numeric codes.
+type keymapFile struct {
+	Actions map[string][]keymapBinding `json:"actions"`
+}
+
+type keymapBinding struct {
+	Key  string   `json:"key"`
+	Mods []string `json:"mods,omitempty"`
+}
+
+// SaveKeymap writes the current bindings to path as JSON.
+func (m *ActionMap) SaveKeymap(path string) error {
+	file := keymapFile{Actions: make(map[string][]keymapBinding, len(m.bindings))}
+	for name, bindings := range m.bindings {
+		for _, b := range bindings {
+			keyName, ok := glfwKeyToName[b.Key]
+			if !ok {
+				return fmt.Errorf("input: no name registered for key %v", b.Key)
+			}
+			file.Actions[name] = append(file.Actions[name], keymapBinding{
+				Key:  keyName,
+				Mods: modsToNames(b.Mods),
+			})
+		}
+	}
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("input: marshal keymap: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("input: write keymap %s: %w", path, err)
+	}
+	return nil
+}
+
+// LoadKeymap replaces the current bindings with those read from path.
+func (m *ActionMap) LoadKeymap(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("input: read keymap %s: %w", path, err)
+	}
+
+	var file keymapFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("input: unmarshal keymap %s: %w", path, err)
+	}
+
+	bindings := make(map[string][]binding, len(file.Actions))
+	for name, entries := range file.Actions {
+		for _, entry := range entries {
+			key, ok := nameToGLFWKey[entry.Key]
+			if !ok {
+				return fmt.Errorf("input: unknown key name %q for action %q", entry.Key, name)
+			}
+			mods, err := namesToMods(entry.Mods)
+			if err != nil {
+				return fmt.Errorf("input: action %q: %w", name, err)
+			}
+			bindings[name] = append(bindings[name], binding{Key: key, Mods: mods})
+		}
+	}
+
+	m.bindings = bindings
+	return nil
+}
+
+// nameToGLFWKey maps human-readable key names, as used in keymap JSON
+// files, to glfw key codes. It mirrors ebiten's generated nameToGLFWKeys
+// table, covering the keys players are actually likely to rebind.
+var nameToGLFWKey = map[string]glfw.Key{
+	"Space":        glfw.KeySpace,
+	"Apostrophe":   glfw.KeyApostrophe,
+	"Comma":        glfw.KeyComma,
+	"Minus":        glfw.KeyMinus,
+	"Period":       glfw.KeyPeriod,
+	"Slash":        glfw.KeySlash,
+	"0":            glfw.Key0,
+	"1":            glfw.Key1,
+	"2":            glfw.Key2,
+	"3":            glfw.Key3,
+	"4":            glfw.Key4,
+	"5":            glfw.Key5,
+	"6":            glfw.Key6,
+	"7":            glfw.Key7,
+	"8":            glfw.Key8,
+	"9":            glfw.Key9,
+	"Semicolon":    glfw.KeySemicolon,
+	"Equal":        glfw.KeyEqual,
+	"A":            glfw.KeyA,
+	"B":            glfw.KeyB,
+	"C":            glfw.KeyC,
+	"D":            glfw.KeyD,
+	"E":            glfw.KeyE,
+	"F":            glfw.KeyF,
+	"G":            glfw.KeyG,
+	"H":            glfw.KeyH,
+	"I":            glfw.KeyI,
+	"J":            glfw.KeyJ,
+	"K":            glfw.KeyK,
+	"L":            glfw.KeyL,
+	"M":            glfw.KeyM,
+	"N":            glfw.KeyN,
+	"O":            glfw.KeyO,
+	"P":            glfw.KeyP,
+	"Q":            glfw.KeyQ,
+	"R":            glfw.KeyR,
+	"S":            glfw.KeyS,
+	"T":            glfw.KeyT,
+	"U":            glfw.KeyU,
+	"V":            glfw.KeyV,
+	"W":            glfw.KeyW,
+	"X":            glfw.KeyX,
+	"Y":            glfw.KeyY,
+	"Z":            glfw.KeyZ,
+	"Escape":       glfw.KeyEscape,
+	"Enter":        glfw.KeyEnter,
+	"Tab":          glfw.KeyTab,
+	"Backspace":    glfw.KeyBackspace,
+	"Up":           glfw.KeyUp,
+	"Down":         glfw.KeyDown,
+	"Left":         glfw.KeyLeft,
+	"Right":        glfw.KeyRight,
+	"LeftShift":    glfw.KeyLeftShift,
+	"LeftControl":  glfw.KeyLeftControl,
+	"LeftAlt":      glfw.KeyLeftAlt,
+	"RightShift":   glfw.KeyRightShift,
+	"RightControl": glfw.KeyRightControl,
+	"RightAlt":     glfw.KeyRightAlt,
+	"LeftSuper":    glfw.KeyLeftSuper,
+	"RightSuper":   glfw.KeyRightSuper,
+}
+
+// glfwKeyToName is the reverse of nameToGLFWKey, built once at init time.
+var glfwKeyToName = reverseKeyNames(nameToGLFWKey)
+
+func reverseKeyNames(names map[string]glfw.Key) map[glfw.Key]string {
+	reversed := make(map[glfw.Key]string, len(names))
+	for name, key := range names {
+		reversed[key] = name
+	}
+	return reversed
+}
+
+// modNames pairs each glfw.ModifierKey bit with its keymap JSON name.
+var modNames = []struct {
+	bit  glfw.ModifierKey
+	name string
+}{
+	{glfw.ModShift, "Shift"},
+	{glfw.ModControl, "Control"},
+	{glfw.ModAlt, "Alt"},
+	{glfw.ModSuper, "Super"},
+}
+
+func modsToNames(mods glfw.ModifierKey) []string {
+	var names []string
+	for _, m := range modNames {
+		if mods&m.bit != 0 {
+			names = append(names, m.name)
+		}
+	}
+	return names
+}
+
+func namesToMods(names []string) (glfw.ModifierKey, error) {
+	var mods glfw.ModifierKey
+	for _, name := range names {
+		found := false
+		for _, m := range modNames {
+			if m.name == name {
+				mods |= m.bit
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("unknown modifier name %q", name)
+		}
+	}
+	return mods, nil
+}
@@ -0,0 +1,161 @@
+package input
+
+import (
+	"github.com/go-gl/glfw/v3.2/glfw"
+)
+
+// gamepadState remembers the last-seen buttons and axes for one joystick so
+// PollGamepads can detect changes between frames. buttons holds raw
+// glfw.Action values as returned by glfw.GetJoystickButtons.
+type gamepadState struct {
+	buttons []byte
+	axes    []f32
+}
+
+// Dispatcher fans glfw callbacks out to registered KeyboardListener,
+// MouseListener, and GamepadListener implementations, so gameplay code can
+// react to input without every system reimplementing glfw plumbing.
+type Dispatcher struct {
+	keyboardListeners []KeyboardListener
+	mouseListeners    []MouseListener
+	gamepadListeners  []GamepadListener
+
+	cursorScale f64
+	gamepads    map[glfw.Joystick]*gamepadState
+}
+
+// NewDispatcher creates a Dispatcher with a 1:1 cursor scale. Use
+// SetCursorScale to match window content scale on high-DPI displays.
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		cursorScale: 1,
+		gamepads:    make(map[glfw.Joystick]*gamepadState),
+	}
+}
+
+// SetCursorScale sets the factor applied to cursor coordinates before
+// they're passed to CursorMoved.
+func (d *Dispatcher) SetCursorScale(scale f64) {
+	d.cursorScale = scale
+}
+
+// AddKeyboardListener registers l to receive keyboard events.
+func (d *Dispatcher) AddKeyboardListener(l KeyboardListener) {
+	d.keyboardListeners = append(d.keyboardListeners, l)
+}
+
+// AddMouseListener registers l to receive mouse events.
+func (d *Dispatcher) AddMouseListener(l MouseListener) {
+	d.mouseListeners = append(d.mouseListeners, l)
+}
+
+// AddGamepadListener registers l to receive gamepad events.
+func (d *Dispatcher) AddGamepadListener(l GamepadListener) {
+	d.gamepadListeners = append(d.gamepadListeners, l)
+}
+
+// Wire attaches the dispatcher's callbacks to window, replacing any
+// previously set glfw key, mouse button, cursor position, and scroll
+// callbacks.
+func (d *Dispatcher) Wire(window *glfw.Window) {
+	window.SetKeyCallback(d.onKey)
+	window.SetMouseButtonCallback(d.onMouseButton)
+	window.SetCursorPosCallback(d.onCursorPos)
+	window.SetScrollCallback(d.onScroll)
+}
+
+func (d *Dispatcher) onKey(w *glfw.Window, key glfw.Key, scancode i32, action glfw.Action, mods glfw.ModifierKey) {
+	for _, l := range d.keyboardListeners {
+		switch action {
+		case glfw.Press:
+			l.KeyDown(key, scancode, mods)
+		case glfw.Release:
+			l.KeyUp(key, scancode, mods)
+		case glfw.Repeat:
+			l.KeyRepeat(key, scancode, mods)
+		}
+	}
+}
+
+func (d *Dispatcher) onMouseButton(w *glfw.Window, button glfw.MouseButton, action glfw.Action, mods glfw.ModifierKey) {
+	for _, l := range d.mouseListeners {
+		switch action {
+		case glfw.Press:
+			l.MouseButtonDown(button, mods)
+		case glfw.Release:
+			l.MouseButtonUp(button, mods)
+		}
+	}
+}
+
+func (d *Dispatcher) onCursorPos(w *glfw.Window, x, y f64) {
+	x *= d.cursorScale
+	y *= d.cursorScale
+	for _, l := range d.mouseListeners {
+		l.CursorMoved(x, y)
+	}
+}
+
+func (d *Dispatcher) onScroll(w *glfw.Window, xOffset, yOffset f64) {
+	for _, l := range d.mouseListeners {
+		l.Scrolled(xOffset, yOffset)
+	}
+}
+
+// PollGamepads reads the current buttons and axes of joy and calls
+// GamepadButtonDown, GamepadButtonUp, and GamepadAxisChanged on any
+// registered GamepadListener for whatever changed since the last call.
+// Call it once per tick for each joystick you care about; glfw 3.2 has no
+// gamepad callbacks, so this is the only way to observe them. Buttons and
+// axes are identified by their index into glfw.GetJoystickButtons and
+// glfw.GetJoystickAxes, since glfw 3.2 has no named Gamepad* mapping.
+func (d *Dispatcher) PollGamepads(joy glfw.Joystick) {
+	if !glfw.JoystickPresent(joy) {
+		delete(d.gamepads, joy)
+		return
+	}
+
+	buttons := glfw.GetJoystickButtons(joy)
+	axes := glfw.GetJoystickAxes(joy)
+
+	prev, ok := d.gamepads[joy]
+	if !ok {
+		prev = &gamepadState{
+			buttons: make([]byte, len(buttons)),
+			axes:    make([]f32, len(axes)),
+		}
+		d.gamepads[joy] = prev
+	}
+
+	for i, action := range buttons {
+		if i >= len(prev.buttons) {
+			break
+		}
+		if action == prev.buttons[i] {
+			continue
+		}
+		for _, l := range d.gamepadListeners {
+			switch glfw.Action(action) {
+			case glfw.Press:
+				l.GamepadButtonDown(joy, i32(i))
+			case glfw.Release:
+				l.GamepadButtonUp(joy, i32(i))
+			}
+		}
+	}
+
+	for i, value := range axes {
+		if i >= len(prev.axes) {
+			break
+		}
+		if value == prev.axes[i] {
+			continue
+		}
+		for _, l := range d.gamepadListeners {
+			l.GamepadAxisChanged(joy, i32(i), value)
+		}
+	}
+
+	prev.buttons = buttons
+	prev.axes = axes
+}
@@ -0,0 +1,16 @@
+package input
+
+import (
+	"github.com/go-gl/glfw/v3.2/glfw"
+)
+
+// GamepadListener listens for gamepad input. glfw 3.2 has no gamepad event
+// callbacks, so Dispatcher.PollGamepads, called each tick for a given
+// joystick, reads its buttons and axes and calls these methods on change.
+// button and axis are indices into glfw.GetJoystickButtons and
+// glfw.GetJoystickAxes, since glfw 3.2 has no named Gamepad* mapping.
+trait GamepadListener {
+	GamepadButtonDown(joy glfw.Joystick, button i32)
+	GamepadButtonUp(joy glfw.Joystick, button i32)
+	GamepadAxisChanged(joy glfw.Joystick, axis i32, value f32)
+}
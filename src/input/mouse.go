@@ -0,0 +1,16 @@
+package input
+
+import (
+	"github.com/go-gl/glfw/v3.2/glfw"
+)
+
+// MouseListener listens for mouse input. Its functions are called from
+// glfw's callbacks. Cursor coordinates are multiplied by the Dispatcher's
+// cursor scale (1 unless changed with SetCursorScale), mirroring ebiten's
+// cursorPosition handling on high-DPI displays.
+trait MouseListener {
+	MouseButtonDown(button glfw.MouseButton, mods glfw.ModifierKey)
+	MouseButtonUp(button glfw.MouseButton, mods glfw.ModifierKey)
+	CursorMoved(x, y f64)
+	Scrolled(xOffset, yOffset f64)
+}
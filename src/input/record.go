@@ -0,0 +1,281 @@
+package input
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-gl/glfw/v3.2/glfw"
+)
+
+// eventKind identifies which listener method a recorded Event replays.
+type eventKind i32
+
+const (
+	eventKeyDown eventKind = iota
+	eventKeyUp
+	eventKeyRepeat
+	eventMouseButtonDown
+	eventMouseButtonUp
+	eventCursorMoved
+	eventScrolled
+	eventGamepadButtonDown
+	eventGamepadButtonUp
+	eventGamepadAxisChanged
+)
+
+// Event is one recorded input occurrence, timestamped relative to the
+// start of the recording. Only the fields relevant to Kind are populated.
+type Event struct {
+	Kind      eventKind     `json:"kind"`
+	Timestamp time.Duration `json:"timestamp"`
+
+	Key      glfw.Key         `json:"key,omitempty"`
+	Scancode i32              `json:"scancode,omitempty"`
+	Mods     glfw.ModifierKey `json:"mods,omitempty"`
+
+	Button glfw.MouseButton `json:"button,omitempty"`
+	X      f64              `json:"x,omitempty"`
+	Y      f64              `json:"y,omitempty"`
+
+	Joystick      glfw.Joystick `json:"joystick,omitempty"`
+	GamepadButton i32           `json:"gamepadButton,omitempty"`
+	Axis          i32           `json:"axis,omitempty"`
+	Value         f32           `json:"value,omitempty"`
+}
+
+// Recorder timestamps every keyboard, mouse, and gamepad event it receives
+// and buffers them for Save. Wire it into a Dispatcher as all three
+// listener types to capture a full play session for later replay through
+// Player, enabling deterministic bug reports, demo playback, and headless
+// integration tests without a real window.
+type Recorder struct {
+	startedAt  time.Time
+	paused     bool
+	pausedAt   time.Time
+	pausedTime time.Duration
+	events     []Event
+}
+
+// NewRecorder creates a Recorder whose clock starts now.
+func NewRecorder() *Recorder {
+	return &Recorder{startedAt: time.Now()}
+}
+
+// Pause stops the recorder's clock and drops any events received until
+// Resume is called.
+func (r *Recorder) Pause() {
+	if r.paused {
+		return
+	}
+	r.paused = true
+	r.pausedAt = time.Now()
+}
+
+// Resume restarts the recorder's clock after Pause, excluding the paused
+// duration from subsequent event timestamps.
+func (r *Recorder) Resume() {
+	if !r.paused {
+		return
+	}
+	r.paused = false
+	r.pausedTime += time.Since(r.pausedAt)
+}
+
+func (r *Recorder) elapsed() time.Duration {
+	return time.Since(r.startedAt) - r.pausedTime
+}
+
+func (r *Recorder) record(e Event) {
+	if r.paused {
+		return
+	}
+	e.Timestamp = r.elapsed()
+	r.events = append(r.events, e)
+}
+
+// KeyDown records the event. It satisfies KeyboardListener.
+func (r *Recorder) KeyDown(key glfw.Key, scancode i32, mods glfw.ModifierKey) {
+	r.record(Event{Kind: eventKeyDown, Key: key, Scancode: scancode, Mods: mods})
+}
+
+// KeyUp records the event. It satisfies KeyboardListener.
+func (r *Recorder) KeyUp(key glfw.Key, scancode i32, mods glfw.ModifierKey) {
+	r.record(Event{Kind: eventKeyUp, Key: key, Scancode: scancode, Mods: mods})
+}
+
+// KeyRepeat records the event. It satisfies KeyboardListener.
+func (r *Recorder) KeyRepeat(key glfw.Key, scancode i32, mods glfw.ModifierKey) {
+	r.record(Event{Kind: eventKeyRepeat, Key: key, Scancode: scancode, Mods: mods})
+}
+
+// MouseButtonDown records the event. It satisfies MouseListener.
+func (r *Recorder) MouseButtonDown(button glfw.MouseButton, mods glfw.ModifierKey) {
+	r.record(Event{Kind: eventMouseButtonDown, Button: button, Mods: mods})
+}
+
+// MouseButtonUp records the event. It satisfies MouseListener.
+func (r *Recorder) MouseButtonUp(button glfw.MouseButton, mods glfw.ModifierKey) {
+	r.record(Event{Kind: eventMouseButtonUp, Button: button, Mods: mods})
+}
+
+// CursorMoved records the event. It satisfies MouseListener.
+func (r *Recorder) CursorMoved(x, y f64) {
+	r.record(Event{Kind: eventCursorMoved, X: x, Y: y})
+}
+
+// Scrolled records the event. It satisfies MouseListener.
+func (r *Recorder) Scrolled(xOffset, yOffset f64) {
+	r.record(Event{Kind: eventScrolled, X: xOffset, Y: yOffset})
+}
+
+// GamepadButtonDown records the event. It satisfies GamepadListener.
+func (r *Recorder) GamepadButtonDown(joy glfw.Joystick, button i32) {
+	r.record(Event{Kind: eventGamepadButtonDown, Joystick: joy, GamepadButton: button})
+}
+
+// GamepadButtonUp records the event. It satisfies GamepadListener.
+func (r *Recorder) GamepadButtonUp(joy glfw.Joystick, button i32) {
+	r.record(Event{Kind: eventGamepadButtonUp, Joystick: joy, GamepadButton: button})
+}
+
+// GamepadAxisChanged records the event. It satisfies GamepadListener.
+func (r *Recorder) GamepadAxisChanged(joy glfw.Joystick, axis i32, value f32) {
+	r.record(Event{Kind: eventGamepadAxisChanged, Joystick: joy, Axis: axis, Value: value})
+}
+
+// Save writes the recorded events to path as JSON, ordered by timestamp.
+func (r *Recorder) Save(path string) error {
+	data, err := json.MarshalIndent(r.events, "", "  ")
+	if err != nil {
+		return fmt.Errorf("input: marshal recording: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("input: write recording %s: %w", path, err)
+	}
+	return nil
+}
+
+// Player re-emits a recorded event stream through a Dispatcher at the
+// recorded offsets, for deterministic replay of a Recorder's output.
+type Player struct {
+	events      []Event
+	next        i32
+	dispatcher  *Dispatcher
+	startedAt   time.Time
+	fastForward f64
+}
+
+// LoadPlayer reads a recording saved by Recorder.Save from path and
+// prepares a Player that will replay it through dispatcher.
+func LoadPlayer(path string, dispatcher *Dispatcher) (*Player, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("input: read recording %s: %w", path, err)
+	}
+
+	var events []Event
+	if err := json.Unmarshal(data, &events); err != nil {
+		return nil, fmt.Errorf("input: unmarshal recording %s: %w", path, err)
+	}
+
+	return &Player{
+		events:      events,
+		dispatcher:  dispatcher,
+		startedAt:   time.Now(),
+		fastForward: 1,
+	}, nil
+}
+
+// SetFastForward scales the player's clock by factor, collapsing the time
+// between events. A factor of 2 replays the recording twice as fast; 0
+// replays every remaining event immediately on the next Update.
+func (p *Player) SetFastForward(factor f64) {
+	p.fastForward = factor
+}
+
+// Seek jumps playback to t, measured from the start of the recording,
+// without dispatching the events between the old and new position. It
+// accounts for the current fast-forward factor so playback resumes at t
+// regardless of how fast the clock is running.
+func (p *Player) Seek(t time.Duration) {
+	if p.fastForward > 0 {
+		p.startedAt = time.Now().Add(-time.Duration(f64(t) / p.fastForward))
+	} else {
+		p.startedAt = time.Now()
+	}
+	p.next = 0
+	for p.next < i32(len(p.events)) && p.events[p.next].Timestamp < t {
+		p.next++
+	}
+}
+
+// Done reports whether every event has been dispatched.
+func (p *Player) Done() bool {
+	return p.next >= i32(len(p.events))
+}
+
+// Update dispatches every event whose recorded timestamp has been reached,
+// scaled by the current fast-forward factor. A factor of 0 or less
+// dispatches every remaining event immediately. Call it once per tick.
+func (p *Player) Update() {
+	if p.fastForward <= 0 {
+		for p.next < i32(len(p.events)) {
+			p.dispatch(p.events[p.next])
+			p.next++
+		}
+		return
+	}
+
+	elapsed := time.Duration(f64(time.Since(p.startedAt)) * p.fastForward)
+	for p.next < i32(len(p.events)) && p.events[p.next].Timestamp <= elapsed {
+		p.dispatch(p.events[p.next])
+		p.next++
+	}
+}
+
+func (p *Player) dispatch(e Event) {
+	switch e.Kind {
+	case eventKeyDown:
+		for _, l := range p.dispatcher.keyboardListeners {
+			l.KeyDown(e.Key, e.Scancode, e.Mods)
+		}
+	case eventKeyUp:
+		for _, l := range p.dispatcher.keyboardListeners {
+			l.KeyUp(e.Key, e.Scancode, e.Mods)
+		}
+	case eventKeyRepeat:
+		for _, l := range p.dispatcher.keyboardListeners {
+			l.KeyRepeat(e.Key, e.Scancode, e.Mods)
+		}
+	case eventMouseButtonDown:
+		for _, l := range p.dispatcher.mouseListeners {
+			l.MouseButtonDown(e.Button, e.Mods)
+		}
+	case eventMouseButtonUp:
+		for _, l := range p.dispatcher.mouseListeners {
+			l.MouseButtonUp(e.Button, e.Mods)
+		}
+	case eventCursorMoved:
+		for _, l := range p.dispatcher.mouseListeners {
+			l.CursorMoved(e.X, e.Y)
+		}
+	case eventScrolled:
+		for _, l := range p.dispatcher.mouseListeners {
+			l.Scrolled(e.X, e.Y)
+		}
+	case eventGamepadButtonDown:
+		for _, l := range p.dispatcher.gamepadListeners {
+			l.GamepadButtonDown(e.Joystick, e.GamepadButton)
+		}
+	case eventGamepadButtonUp:
+		for _, l := range p.dispatcher.gamepadListeners {
+			l.GamepadButtonUp(e.Joystick, e.GamepadButton)
+		}
+	case eventGamepadAxisChanged:
+		for _, l := range p.dispatcher.gamepadListeners {
+			l.GamepadAxisChanged(e.Joystick, e.Axis, e.Value)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package input
+
+import (
+	"github.com/go-gl/glfw/v3.2/glfw"
+)
+
+// InputState maintains a per-frame snapshot of pressed keys so game systems
+// can poll input imperatively each tick instead of implementing
+// KeyboardListener themselves. Register it as a KeyboardListener before any
+// other listener that needs to see "just pressed"/"just released" edges,
+// since those are derived from the snapshot taken by the previous Update.
+type InputState struct {
+	pressed  map[glfw.Key]struct{}
+	previous map[glfw.Key]struct{}
+}
+
+// NewInputState creates an InputState with empty pressed sets.
+func NewInputState() *InputState {
+	return &InputState{
+		pressed:  make(map[glfw.Key]struct{}),
+		previous: make(map[glfw.Key]struct{}),
+	}
+}
+
+// KeyDown marks key as pressed. It satisfies KeyboardListener.
+func (s *InputState) KeyDown(key glfw.Key, scancode i32, mods glfw.ModifierKey) {
+	s.pressed[key] = struct{}{}
+}
+
+// KeyUp marks key as released. It satisfies KeyboardListener.
+func (s *InputState) KeyUp(key glfw.Key, scancode i32, mods glfw.ModifierKey) {
+	delete(s.pressed, key)
+}
+
+// KeyRepeat is a no-op; repeat events don't change the pressed set. It
+// satisfies KeyboardListener.
+func (s *InputState) KeyRepeat(key glfw.Key, scancode i32, mods glfw.ModifierKey) {}
+
+// Update snapshots the current pressed set as the previous frame's state.
+// Call it once per tick, after gameplay systems have polled this frame's
+// input, so IsKeyJustPressed/IsKeyJustReleased reflect the edge between the
+// two most recent frames.
+func (s *InputState) Update() {
+	previous := make(map[glfw.Key]struct{}, len(s.pressed))
+	for key := range s.pressed {
+		previous[key] = struct{}{}
+	}
+	s.previous = previous
+}
+
+// IsKeyPressed reports whether key is currently held down.
+func (s *InputState) IsKeyPressed(key glfw.Key) bool {
+	_, ok := s.pressed[key]
+	return ok
+}
+
+// IsKeyJustPressed reports whether key transitioned from released to
+// pressed since the last Update.
+func (s *InputState) IsKeyJustPressed(key glfw.Key) bool {
+	_, now := s.pressed[key]
+	_, before := s.previous[key]
+	return now && !before
+}
+
+// IsKeyJustReleased reports whether key transitioned from pressed to
+// released since the last Update.
+func (s *InputState) IsKeyJustReleased(key glfw.Key) bool {
+	_, now := s.pressed[key]
+	_, before := s.previous[key]
+	return before && !now
+}
+
+// ActiveMods reports the modifier keys currently held, derived from the
+// left/right shift, control, alt, and super keys' pressed state.
+func (s *InputState) ActiveMods() glfw.ModifierKey {
+	var mods glfw.ModifierKey
+	if s.IsKeyPressed(glfw.KeyLeftShift) || s.IsKeyPressed(glfw.KeyRightShift) {
+		mods |= glfw.ModShift
+	}
+	if s.IsKeyPressed(glfw.KeyLeftControl) || s.IsKeyPressed(glfw.KeyRightControl) {
+		mods |= glfw.ModControl
+	}
+	if s.IsKeyPressed(glfw.KeyLeftAlt) || s.IsKeyPressed(glfw.KeyRightAlt) {
+		mods |= glfw.ModAlt
+	}
+	if s.IsKeyPressed(glfw.KeyLeftSuper) || s.IsKeyPressed(glfw.KeyRightSuper) {
+		mods |= glfw.ModSuper
+	}
+	return mods
+}